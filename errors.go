@@ -0,0 +1,109 @@
+package asana
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// AsanaError represents a single error as returned by the Asana API in the
+// `{"errors":[{"message":...,"help":...,"phrase":...}]}` envelope that
+// accompanies non-2xx responses.
+type AsanaError struct {
+	// Message is a human-readable explanation of what went wrong.
+	Message string `json:"message"`
+
+	// Help points the caller at documentation describing the error in more
+	// detail, if Asana provided one.
+	Help string `json:"help"`
+
+	// Phrase is a unique phrase identifying the request that failed, useful
+	// when contacting Asana support.
+	Phrase string `json:"phrase"`
+
+	// StatusCode is the HTTP status code that accompanied this error.
+	StatusCode int `json:"-"`
+}
+
+func (e *AsanaError) Error() string {
+	if e.Help != "" {
+		return fmt.Sprintf("asana: %s (%s)", e.Message, e.Help)
+	}
+	return fmt.Sprintf("asana: %s", e.Message)
+}
+
+// asanaErrorEnvelope mirrors the JSON body Asana sends back alongside
+// non-2xx responses.
+type asanaErrorEnvelope struct {
+	Errors []*AsanaError `json:"errors"`
+}
+
+// Sentinel errors for the most common HTTP status codes returned by the
+// Asana API, so callers can branch on failure kind with errors.Is without
+// inspecting status codes themselves.
+var (
+	ErrUnauthorized = errors.New("asana: unauthorized")
+	ErrNotFound     = errors.New("asana: not found")
+	ErrRateLimited  = errors.New("asana: rate limited")
+)
+
+// wrappedAsanaError pairs one of the sentinel errors above with the
+// *AsanaError Asana actually returned, so callers can either errors.Is
+// against the sentinel or errors.As for the message/help/phrase.
+type wrappedAsanaError struct {
+	sentinel error
+	*AsanaError
+}
+
+func (w *wrappedAsanaError) Is(target error) bool { return target == w.sentinel }
+func (w *wrappedAsanaError) Unwrap() error        { return w.AsanaError }
+
+// HTTPError is the error a Client's low-level get/post/put/delete helpers
+// return when a request completes with a non-2xx response. It carries the
+// raw status code and body so the Asana error envelope can be decoded.
+type HTTPError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("asana: request failed with status %d", e.StatusCode)
+}
+
+// asanaError converts an error returned by the Client into a structured
+// *AsanaError (or one of the sentinel errors) by decoding its Asana error
+// envelope. Errors that are not an *HTTPError, such as network failures,
+// are returned unchanged.
+func asanaError(err error) error {
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		return err
+	}
+	return parseAsanaError(httpErr.StatusCode, httpErr.Body)
+}
+
+// parseAsanaError decodes a non-2xx HTTP response body into an error,
+// translating the well-known status codes into their sentinel errors. It is
+// used by asanaError, which the package's resource methods call to surface
+// structured errors to their callers.
+func parseAsanaError(statusCode int, body []byte) error {
+	envelope := &asanaErrorEnvelope{}
+	if err := json.Unmarshal(body, envelope); err != nil || len(envelope.Errors) == 0 {
+		return &AsanaError{Message: string(body), StatusCode: statusCode}
+	}
+
+	asanaErr := envelope.Errors[0]
+	asanaErr.StatusCode = statusCode
+
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &wrappedAsanaError{ErrUnauthorized, asanaErr}
+	case http.StatusNotFound:
+		return &wrappedAsanaError{ErrNotFound, asanaErr}
+	case http.StatusTooManyRequests:
+		return &wrappedAsanaError{ErrRateLimited, asanaErr}
+	default:
+		return asanaErr
+	}
+}
@@ -0,0 +1,86 @@
+package asana
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestParseAsanaError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantIs     error
+		wantMsg    string
+	}{
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"errors":[{"message":"Not Authorized","help":"For more information on OAuth, see..."}]}`,
+			wantIs:     ErrUnauthorized,
+			wantMsg:    "Not Authorized",
+		},
+		{
+			name:       "forbidden maps to unauthorized",
+			statusCode: http.StatusForbidden,
+			body:       `{"errors":[{"message":"Forbidden"}]}`,
+			wantIs:     ErrUnauthorized,
+			wantMsg:    "Forbidden",
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"errors":[{"message":"Not Found: story"}]}`,
+			wantIs:     ErrNotFound,
+			wantMsg:    "Not Found: story",
+		},
+		{
+			name:       "rate limited",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"errors":[{"message":"Rate Limit Enforced"}]}`,
+			wantIs:     ErrRateLimited,
+			wantMsg:    "Rate Limit Enforced",
+		},
+		{
+			name:       "unrecognized status returns plain AsanaError",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"errors":[{"message":"Server Error"}]}`,
+			wantMsg:    "Server Error",
+		},
+		{
+			name:       "unparseable body falls back to raw message",
+			statusCode: http.StatusBadRequest,
+			body:       `not json`,
+			wantMsg:    "not json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseAsanaError(tt.statusCode, []byte(tt.body))
+
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.wantIs)
+			}
+
+			var asanaErr *AsanaError
+			if !errors.As(err, &asanaErr) {
+				t.Fatalf("errors.As(%v, &AsanaError{}) = false, want true", err)
+			}
+			if asanaErr.Message != tt.wantMsg {
+				t.Errorf("Message = %q, want %q", asanaErr.Message, tt.wantMsg)
+			}
+			if asanaErr.StatusCode != tt.statusCode {
+				t.Errorf("StatusCode = %d, want %d", asanaErr.StatusCode, tt.statusCode)
+			}
+		})
+	}
+}
+
+func TestAsanaErrorPassesThroughNonHTTPErrors(t *testing.T) {
+	original := errors.New("network timeout")
+	if got := asanaError(original); got != original {
+		t.Errorf("asanaError(%v) = %v, want unchanged", original, got)
+	}
+}
@@ -0,0 +1,59 @@
+package asana
+
+import "fmt"
+
+// followersRequest is the body accepted by the addFollowers/removeFollowers
+// endpoints.
+type followersRequest struct {
+	Followers []string `json:"followers"`
+}
+
+// userGIDs collects the GIDs of a slice of users, for endpoints that accept
+// either full resources or bare GID strings.
+func userGIDs(users []*User) []string {
+	gids := make([]string, len(users))
+	for i, u := range users {
+		gids[i] = u.ID
+	}
+	return gids
+}
+
+// AddFollowers adds the given users as followers of the task and returns
+// the updated task. It shares the same client, and so the same rate-limit
+// budget and retry policy, as CreateComment — handy since the two are
+// commonly used together to mention and auto-follow a user.
+func (t *Task) AddFollowers(client *Client, users []*User) (*Task, error) {
+	return t.AddFollowersByGID(client, userGIDs(users))
+}
+
+// AddFollowersByGID is AddFollowers for callers that already have the
+// followers' GIDs rather than full *User resources.
+func (t *Task) AddFollowersByGID(client *Client, gids []string) (*Task, error) {
+	client.info("Adding followers to task %q", t.Name)
+
+	result := &Task{}
+	err := client.post(fmt.Sprintf("/tasks/%s/addFollowers", t.ID), &followersRequest{Followers: gids}, result)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
+}
+
+// RemoveFollowers removes the given users as followers of the task and
+// returns the updated task.
+func (t *Task) RemoveFollowers(client *Client, users []*User) (*Task, error) {
+	return t.RemoveFollowersByGID(client, userGIDs(users))
+}
+
+// RemoveFollowersByGID is RemoveFollowers for callers that already have the
+// followers' GIDs rather than full *User resources.
+func (t *Task) RemoveFollowersByGID(client *Client, gids []string) (*Task, error) {
+	client.info("Removing followers from task %q", t.Name)
+
+	result := &Task{}
+	err := client.post(fmt.Sprintf("/tasks/%s/removeFollowers", t.ID), &followersRequest{Followers: gids}, result)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package asana
+
+import "fmt"
+
+// APIVersion selects which generation of Asana's affection endpoints a
+// Client talks to. Asana is migrating "hearts" to "likes"; Heart/Unheart
+// pick the matching endpoint based on Client.APIVersion. Story has both
+// generations of fields (Hearted/Hearts/NumHearts and Liked/Likes/NumLikes);
+// Heart/Unheart decode the response into the resource as it comes, so only
+// the fields the response carries are set. Values are not copied from one
+// generation's fields to the other's.
+type APIVersion string
+
+const (
+	// APIVersionLikes talks to the current `likes` endpoints. This is the
+	// default for a zero-value Client.
+	APIVersionLikes APIVersion = "likes"
+
+	// APIVersionHearts talks to the legacy `hearts` endpoints.
+	APIVersionHearts APIVersion = "hearts"
+)
+
+// heartActionPath builds the addLikes/removeLikes (or addHearts/
+// removeHearts) path for a resource, depending on version.
+func heartActionPath(resourceType, id string, version APIVersion, add bool) string {
+	noun := "Likes"
+	if version == APIVersionHearts {
+		noun = "Hearts"
+	}
+
+	verb := "add"
+	if !add {
+		verb = "remove"
+	}
+
+	return fmt.Sprintf("/%s/%s/%s%s", resourceType, id, verb, noun)
+}
+
+// Heart hearts (or likes, depending on Client.APIVersion) the story on
+// behalf of the authorized user, updating the story in place with the
+// response.
+func (s *Story) Heart(client *Client) error {
+	client.info("Hearting story %s", s.ID)
+
+	return asanaError(client.post(heartActionPath("stories", s.ID, client.APIVersion, true), nil, s))
+}
+
+// Unheart removes the authorized user's heart (or like) from the story,
+// updating the story in place with the response.
+func (s *Story) Unheart(client *Client) error {
+	client.info("Unhearting story %s", s.ID)
+
+	return asanaError(client.post(heartActionPath("stories", s.ID, client.APIVersion, false), nil, s))
+}
+
+// Heart hearts (or likes, depending on Client.APIVersion) the task on
+// behalf of the authorized user. The task is decoded in place with the
+// response, so any affection fields Task's own definition exposes (e.g. a
+// Hearted/Liked pair, mirroring Story) are refreshed; this commit does not
+// itself add those fields to Task.
+func (t *Task) Heart(client *Client) error {
+	client.info("Hearting task %s", t.ID)
+
+	return asanaError(client.post(heartActionPath("tasks", t.ID, client.APIVersion, true), nil, t))
+}
+
+// Unheart removes the authorized user's heart (or like) from the task. As
+// with Heart, only the affection fields already present on Task's
+// definition are refreshed by the response.
+func (t *Task) Unheart(client *Client) error {
+	client.info("Unhearting task %s", t.ID)
+
+	return asanaError(client.post(heartActionPath("tasks", t.ID, client.APIVersion, false), nil, t))
+}
@@ -0,0 +1,151 @@
+package asana
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// CompactResource is a minimal reference to an Asana resource — just
+// enough to identify it — as used wherever a related resource is embedded
+// without being expanded via opt_fields.
+type CompactResource struct {
+	ID           string `json:"gid,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// AccessLevel is the level of access a Membership grants over its parent
+// resource.
+type AccessLevel string
+
+// The documented membership access levels.
+const (
+	AccessLevelAdmin     AccessLevel = "admin"
+	AccessLevelEditor    AccessLevel = "editor"
+	AccessLevelCommenter AccessLevel = "commenter"
+	AccessLevelViewer    AccessLevel = "viewer"
+)
+
+// Membership represents a user or team's access to a project, portfolio,
+// team, or goal. Memberships are how Asana models who can see and act on
+// a resource, and at what level.
+type Membership struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// The user or team that holds this membership.
+	Member *CompactResource `json:"member,omitempty"`
+
+	// The project, portfolio, team, or goal this membership grants access
+	// to.
+	ParentResource *CompactResource `json:"parent,omitempty"`
+
+	// The level of access this membership grants.
+	AccessLevel AccessLevel `json:"access_level,omitempty"`
+
+	// Whether the membership is currently active.
+	IsActive bool `json:"is_active,omitempty"`
+
+	// Read-only. The user-facing name for this membership's access level,
+	// e.g. "Project Admin".
+	Role string `json:"role,omitempty"`
+}
+
+// MembershipUpdate carries the fields that can be changed on a Membership.
+// Fields are pointers so that a partial update only sends the ones the
+// caller actually set — a zero-value AccessLevel or IsActive left unset
+// should not be mistaken for an explicit change.
+type MembershipUpdate struct {
+	AccessLevel *AccessLevel `json:"access_level,omitempty"`
+	IsActive    *bool        `json:"is_active,omitempty"`
+}
+
+// membershipCreate is the body sent to create a new membership on a parent
+// resource.
+type membershipCreate struct {
+	Parent      *CompactResource `json:"parent,omitempty"`
+	Member      *CompactResource `json:"member,omitempty"`
+	AccessLevel AccessLevel      `json:"access_level,omitempty"`
+}
+
+// Memberships lists the memberships on an arbitrary parent resource —
+// project, portfolio, team, or goal — via the top-level GET /memberships
+// endpoint, which accepts any of those as its `parent` query parameter.
+// Resource-specific helpers like Project.Memberships call this with their
+// own GID; portfolios, teams, and goals can use it directly until they
+// grow the same sugar.
+func (c *Client) Memberships(parentGID string, opts ...*Options) ([]*Membership, *NextPage, error) {
+	c.trace("Listing memberships for %q", parentGID)
+
+	var result []*Membership
+
+	path := fmt.Sprintf("/memberships?parent=%s", url.QueryEscape(parentGID))
+	nextPage, err := c.get(path, nil, &result, opts...)
+	if err != nil {
+		return nil, nil, asanaError(err)
+	}
+	return result, nextPage, nil
+}
+
+// CreateMembership grants member access to parent at the given access
+// level, via the top-level POST /memberships endpoint, and returns the
+// created membership. Resource-specific helpers like Project.AddMember
+// call this with their own GID and resource type; portfolios, teams, and
+// goals can use it directly until they grow the same sugar.
+func (c *Client) CreateMembership(parent, member *CompactResource, accessLevel AccessLevel) (*Membership, error) {
+	c.info("Creating membership for %s on %s", member.ID, parent.ID)
+
+	result := &Membership{}
+	body := &membershipCreate{
+		Parent:      parent,
+		Member:      member,
+		AccessLevel: accessLevel,
+	}
+
+	err := c.post("/memberships", body, result)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
+}
+
+// Memberships lists the memberships on the project.
+func (p *Project) Memberships(client *Client, opts ...*Options) ([]*Membership, *NextPage, error) {
+	client.trace("Listing memberships for %q", p.Name)
+
+	return client.Memberships(p.ID, opts...)
+}
+
+// AddMember grants member access to the project at the given access
+// level, returning the created membership.
+func (p *Project) AddMember(client *Client, member *User, accessLevel AccessLevel) (*Membership, error) {
+	client.info("Adding %q as a member of project %q", member.Name, p.Name)
+
+	return client.CreateMembership(
+		&CompactResource{ID: p.ID, ResourceType: "project"},
+		&CompactResource{ID: member.ID, ResourceType: "user"},
+		accessLevel,
+	)
+}
+
+// Update applies a partial update to the membership and returns the full
+// record for the updated membership.
+func (m *Membership) Update(client *Client, update *MembershipUpdate) (*Membership, error) {
+	client.info("Updating membership %s", m.ID)
+
+	result := &Membership{}
+
+	err := client.put(fmt.Sprintf("/memberships/%s", m.ID), update, result)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
+}
+
+// Delete removes the membership, revoking the member's access to the
+// parent resource.
+func (m *Membership) Delete(client *Client) error {
+	client.info("Deleting membership %s", m.ID)
+
+	return asanaError(client.delete(fmt.Sprintf("/memberships/%s", m.ID)))
+}
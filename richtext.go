@@ -0,0 +1,119 @@
+package asana
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// mentionable is implemented by resources that can be `@mentioned` inside a
+// rich-text comment: User, Task, and Project all expose a GID that Asana
+// resolves into a live mention link.
+type mentionable interface {
+	mentionGID() string
+}
+
+func (u *User) mentionGID() string    { return u.ID }
+func (t *Task) mentionGID() string    { return t.ID }
+func (p *Project) mentionGID() string { return p.ID }
+
+// RichText incrementally builds the restricted HTML subset Asana accepts
+// for a story's HTMLText: bold, italic, links, code blocks, and
+// `data-asana-gid` mentions of users, tasks, and projects. All text passed
+// in is escaped, so callers can safely build comments out of untrusted
+// input without risking HTML injection; Link additionally rejects
+// dangerous URL schemes such as `javascript:`, since escaping alone
+// doesn't neutralize those.
+//
+// Use NewRichText, chain the builder methods, and call Build to obtain the
+// `<body>`-wrapped HTML string expected by StoryBase.HTMLText:
+//
+//	html := asana.NewRichText().
+//		Text("Hey ").
+//		Mention(assignee).
+//		Text(", please take a look: ").
+//		Link("https://example.com", "the doc").
+//		Build()
+type RichText struct {
+	b strings.Builder
+}
+
+// NewRichText creates an empty RichText builder.
+func NewRichText() *RichText {
+	return &RichText{}
+}
+
+// Text appends escaped, unformatted text.
+func (r *RichText) Text(text string) *RichText {
+	r.b.WriteString(html.EscapeString(text))
+	return r
+}
+
+// Bold appends text wrapped in a <strong> tag.
+func (r *RichText) Bold(text string) *RichText {
+	fmt.Fprintf(&r.b, "<strong>%s</strong>", html.EscapeString(text))
+	return r
+}
+
+// Italic appends text wrapped in an <em> tag.
+func (r *RichText) Italic(text string) *RichText {
+	fmt.Fprintf(&r.b, "<em>%s</em>", html.EscapeString(text))
+	return r
+}
+
+// Code appends text wrapped in a <code> tag.
+func (r *RichText) Code(snippet string) *RichText {
+	fmt.Fprintf(&r.b, "<code>%s</code>", html.EscapeString(snippet))
+	return r
+}
+
+// linkSchemes are the URL schemes Link will render as a clickable <a
+// href>. Anything else (notably "javascript:") is rendered as plain text
+// instead, since HTML-escaping a URL does nothing to neutralize a
+// dangerous scheme. An empty scheme is allowed for relative links.
+var linkSchemes = map[string]bool{
+	"":       true,
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// Link appends an <a> tag pointing at rawURL, labeled with label. If
+// rawURL doesn't parse or uses a scheme outside linkSchemes, label is
+// appended as plain escaped text instead of being linked.
+func (r *RichText) Link(rawURL, label string) *RichText {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || !linkSchemes[strings.ToLower(parsed.Scheme)] {
+		r.b.WriteString(html.EscapeString(label))
+		return r
+	}
+
+	fmt.Fprintf(&r.b, `<a href="%s">%s</a>`, html.EscapeString(rawURL), html.EscapeString(label))
+	return r
+}
+
+// Mention appends a `data-asana-gid` mention of a *User, *Task, or
+// *Project, rendered the way Asana expects in order to notify and link to
+// that resource. The visible label defaults to the resource's name; it is
+// escaped like any other text.
+func (r *RichText) Mention(resource mentionable) *RichText {
+	label := ""
+	switch v := resource.(type) {
+	case *User:
+		label = v.Name
+	case *Task:
+		label = v.Name
+	case *Project:
+		label = v.Name
+	}
+
+	fmt.Fprintf(&r.b, `<a data-asana-gid="%s">%s</a>`, html.EscapeString(resource.mentionGID()), html.EscapeString(label))
+	return r
+}
+
+// Build returns the accumulated HTML, wrapped in the `<body>` element
+// Asana requires for html_text.
+func (r *RichText) Build() string {
+	return fmt.Sprintf("<body>%s</body>", r.b.String())
+}
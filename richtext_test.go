@@ -0,0 +1,66 @@
+package asana
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRichTextEscaping(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "plain text is escaped",
+			got:  NewRichText().Text(`<script>alert(1)</script>`).Build(),
+			want: `<body>&lt;script&gt;alert(1)&lt;/script&gt;</body>`,
+		},
+		{
+			name: "bold escapes its argument",
+			got:  NewRichText().Bold(`" onmouseover="alert(1)`).Build(),
+			want: `<body><strong>&#34; onmouseover=&#34;alert(1)</strong></body>`,
+		},
+		{
+			name: "link escapes url and label",
+			got:  NewRichText().Link(`https://example.com/a"b`, `click "me"`).Build(),
+			want: `<body><a href="https://example.com/a&#34;b">click &#34;me&#34;</a></body>`,
+		},
+		{
+			name: "link allows a relative url",
+			got:  NewRichText().Link(`/foo/bar`, `relative`).Build(),
+			want: `<body><a href="/foo/bar">relative</a></body>`,
+		},
+		{
+			name: "link rejects javascript scheme, rendering plain text instead",
+			got:  NewRichText().Link(`javascript:alert(1)`, `click me`).Build(),
+			want: `<body>click me</body>`,
+		},
+		{
+			name: "link rejects data scheme, rendering plain text instead",
+			got:  NewRichText().Link(`data:text/html,<script>alert(1)</script>`, `click me`).Build(),
+			want: `<body>click me</body>`,
+		},
+		{
+			name: "code escapes its argument",
+			got:  NewRichText().Code(`<img src=x onerror=alert(1)>`).Build(),
+			want: `<body><code>&lt;img src=x onerror=alert(1)&gt;</code></body>`,
+		},
+		{
+			name: "mention renders data-asana-gid with escaped label",
+			got:  NewRichText().Mention(&User{ID: "123", Name: `<b>evil</b>`}).Build(),
+			want: `<body><a data-asana-gid="123">&lt;b&gt;evil&lt;/b&gt;</a></body>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+			if strings.Contains(tt.got, "<script>") || strings.Contains(tt.got, "<img ") {
+				t.Errorf("unescaped injection survived in output: %q", tt.got)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package asana
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
@@ -23,8 +24,11 @@ type StoryBase struct {
 	// opt_fields query parameter.
 	HTMLText string `json:"html_text,omitempty"`
 
-	// Whether the story should be pinned on the resource.
-	IsPinned bool `json:"is_pinned,omitempty"`
+	// Whether the story should be pinned on the resource. A pointer so that
+	// explicitly unpinning a story (IsPinned: false) is distinguishable
+	// from leaving it unset — omitempty would otherwise drop a literal
+	// false and silently no-op the unpin.
+	IsPinned *bool `json:"is_pinned,omitempty"`
 }
 
 // Story represents an activity associated with an object in the Asana
@@ -52,6 +56,17 @@ type Story struct {
 	// Read-only. The number of users who have hearted this object.
 	NumHearts int32 `json:"num_hearts,omitempty"`
 
+	// True if the object is liked by the authorized user, false if not.
+	// Mirrors Hearted as the API migrates from hearts to likes.
+	Liked bool `json:"liked,omitempty"`
+
+	// Read-only. Array of users who have liked this object. Mirrors Hearts.
+	Likes []*User `json:"likes,omitempty"`
+
+	// Read-only. The number of users who have liked this object. Mirrors
+	// NumHearts.
+	NumLikes int32 `json:"num_likes,omitempty"`
+
 	// The user who created the story.
 	CreatedBy *User `json:"created_by,omitempty"`
 
@@ -65,6 +80,166 @@ type Story struct {
 
 	// Read-only. The type of story this is.
 	Type string `json:"type,omitempty"`
+
+	// Read-only. The subtype of this story, which more specifically
+	// describes what kind of event occurred or comment was left.
+	ResourceSubtype StorySubtype `json:"resource_subtype,omitempty"`
+
+	// Read-only. Whether the text of the story has been edited after
+	// creation. Only comment stories can be edited.
+	IsEdited bool `json:"is_edited,omitempty"`
+
+	// Read-only. Whether the story can still be edited by the authorized
+	// user.
+	IsEditable bool `json:"is_editable,omitempty"`
+
+	// Read-only. The name of the sticker applied to this story, if any.
+	StickerName string `json:"sticker_name,omitempty"`
+
+	// Read-only. Unfurled previews of any links contained in the story's
+	// text.
+	Previews []*StoryPreview `json:"previews,omitempty"`
+}
+
+// StoryPreview is an unfurled preview of a link found in a story's text.
+type StoryPreview struct {
+	Title    string `json:"title,omitempty"`
+	Fallback string `json:"fallback,omitempty"`
+	Footer   string `json:"footer,omitempty"`
+	HTMLText string `json:"html_text,omitempty"`
+}
+
+// StorySubtype identifies the kind of event or comment a Story represents.
+// The Asana API documents these as the possible values of
+// resource_subtype on a story.
+type StorySubtype string
+
+// The documented story resource subtypes. This set covers comments as well
+// as the system-generated events the API reports.
+const (
+	StorySubtypeCommentAdded       StorySubtype = "comment_added"
+	StorySubtypeCommentDeleted     StorySubtype = "comment_deleted"
+	StorySubtypeCommentLiked       StorySubtype = "comment_liked"
+	StorySubtypeMarkedComplete     StorySubtype = "marked_complete"
+	StorySubtypeMarkedIncomplete   StorySubtype = "marked_incomplete"
+	StorySubtypeAssigned           StorySubtype = "assigned"
+	StorySubtypeUnassigned         StorySubtype = "unassigned"
+	StorySubtypeAddedToProject     StorySubtype = "added_to_project"
+	StorySubtypeRemovedFromProject StorySubtype = "removed_from_project"
+	StorySubtypeDueDateChanged     StorySubtype = "due_date_changed"
+	StorySubtypeStartDateChanged   StorySubtype = "start_date_changed"
+	StorySubtypeAttachmentAdded    StorySubtype = "attachment_added"
+	StorySubtypeAttachmentDeleted  StorySubtype = "attachment_deleted"
+	StorySubtypeDuplicated         StorySubtype = "duplicated"
+	StorySubtypeSectionChanged     StorySubtype = "section_changed"
+	StorySubtypeFollowerAdded      StorySubtype = "follower_added"
+	StorySubtypeFollowerRemoved    StorySubtype = "follower_removed"
+	StorySubtypeNameChanged        StorySubtype = "name_changed"
+	StorySubtypeDependencyAdded    StorySubtype = "dependency_added"
+	StorySubtypeDependencyRemoved  StorySubtype = "dependency_removed"
+)
+
+// IsComment reports whether this story is about a user-authored comment
+// (added, deleted, or liked), as opposed to a system-generated event. This
+// matches the grouping Event() uses to decode a CommentEvent.
+func (s *Story) IsComment() bool {
+	switch s.ResourceSubtype {
+	case StorySubtypeCommentAdded, StorySubtypeCommentDeleted, StorySubtypeCommentLiked:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSystem reports whether this story was generated by the system in
+// response to an action taken on its target, rather than authored by a
+// user as a comment.
+func (s *Story) IsSystem() bool {
+	return s.ResourceSubtype != "" && !s.IsComment()
+}
+
+// IsComplete reports whether this story records its target being marked
+// complete.
+func (s *Story) IsComplete() bool {
+	return s.ResourceSubtype == StorySubtypeMarkedComplete
+}
+
+// StoryEvent is the result of decoding a Story's ResourceSubtype into a
+// typed event, so that consumers can switch on event kind without
+// string-matching against ResourceSubtype themselves.
+type StoryEvent interface {
+	// Base returns the Story the event was decoded from.
+	Base() *Story
+}
+
+// storyEvent is embedded by every concrete StoryEvent implementation to
+// provide Base().
+type storyEvent struct {
+	story *Story
+}
+
+func (e storyEvent) Base() *Story { return e.story }
+
+// CommentEvent is a user-authored comment story.
+type CommentEvent struct {
+	storyEvent
+}
+
+// AssignedEvent records that the story's target was (re)assigned.
+type AssignedEvent struct {
+	storyEvent
+}
+
+// UnassignedEvent records that the story's target had its assignee removed.
+type UnassignedEvent struct {
+	storyEvent
+}
+
+// DueDateChangedEvent records that the story's target had its due date or
+// start date changed.
+type DueDateChangedEvent struct {
+	storyEvent
+}
+
+// MovedEvent records that the story's target was added to or removed from
+// a project, or moved to another section.
+type MovedEvent struct {
+	storyEvent
+}
+
+// CompletedEvent records that the story's target was marked complete or
+// incomplete.
+type CompletedEvent struct {
+	storyEvent
+}
+
+// GenericEvent is used for any resource subtype without a more specific
+// StoryEvent implementation.
+type GenericEvent struct {
+	storyEvent
+}
+
+// Event decodes the story into its typed StoryEvent, based on
+// ResourceSubtype.
+func (s *Story) Event() StoryEvent {
+	base := storyEvent{story: s}
+
+	switch s.ResourceSubtype {
+	case StorySubtypeCommentAdded, StorySubtypeCommentDeleted, StorySubtypeCommentLiked:
+		return CommentEvent{base}
+	case StorySubtypeAssigned:
+		return AssignedEvent{base}
+	case StorySubtypeUnassigned:
+		return UnassignedEvent{base}
+	case StorySubtypeDueDateChanged, StorySubtypeStartDateChanged:
+		return DueDateChangedEvent{base}
+	case StorySubtypeAddedToProject, StorySubtypeRemovedFromProject, StorySubtypeSectionChanged:
+		return MovedEvent{base}
+	case StorySubtypeMarkedComplete, StorySubtypeMarkedIncomplete:
+		return CompletedEvent{base}
+	default:
+		return GenericEvent{base}
+	}
 }
 
 // Stories lists all stories attached to a task
@@ -78,14 +253,55 @@ func (t *Task) Stories(client *Client, opts ...*Options) ([]*Story, *NextPage, e
 	return result, nextPage, err
 }
 
+// GetStory fetches a single story by its GID.
+func (c *Client) GetStory(id string, opts ...*Options) (*Story, error) {
+	c.trace("Getting story %q", id)
+
+	result := &Story{}
+
+	_, err := c.get(fmt.Sprintf("/stories/%s", id), nil, result, opts...)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
+}
+
+// Delete removes the story. Only comment stories created by the
+// authorized user can be deleted.
+func (s *Story) Delete(client *Client) error {
+	client.info("Deleting story %s", s.ID)
+
+	return asanaError(client.delete(fmt.Sprintf("/stories/%s", s.ID)))
+}
+
+// ErrConflictingStoryText is returned when a StoryBase sets both Text and
+// HTMLText, which the Asana API rejects.
+var ErrConflictingStoryText = errors.New("asana: only one of Text or HTMLText may be set on a story")
+
+// validate enforces the Asana API constraint that a story may only set one
+// of Text or HTMLText.
+func (s *StoryBase) validate() error {
+	if s.Text != "" && s.HTMLText != "" {
+		return ErrConflictingStoryText
+	}
+	return nil
+}
+
 // CreateComment adds a comment story to a task
 func (t *Task) CreateComment(client *Client, story *StoryBase) (*Story, error) {
 	client.info("Creating comment for task %q", t.Name)
 
+	if err := story.validate(); err != nil {
+		return nil, err
+	}
+
 	result := &Story{}
 
-	err := client.post(fmt.Sprintf("/tasks/%s/stories", t.ID), nil, result)
-	return result, err
+	err := client.post(fmt.Sprintf("/tasks/%s/stories", t.ID), story, result)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
 }
 
 // UpdateStory updates the story and returns the full record for the updated story.
@@ -94,8 +310,15 @@ func (t *Task) CreateComment(client *Client, story *StoryBase) (*Story, error) {
 func (s *Story) UpdateStory(client *Client, story *StoryBase) (*Story, error) {
 	client.info("Updating story %s", s.ID)
 
+	if err := story.validate(); err != nil {
+		return nil, err
+	}
+
 	result := &Story{}
 
-	err := client.put(fmt.Sprintf("/stories/%s", s.ID), nil, result)
-	return result, err
+	err := client.put(fmt.Sprintf("/stories/%s", s.ID), story, result)
+	if err != nil {
+		return nil, asanaError(err)
+	}
+	return result, nil
 }
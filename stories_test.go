@@ -0,0 +1,98 @@
+package asana
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCreateCommentUpdateStoryRejectConflictingText(t *testing.T) {
+	client := &Client{}
+	task := &Task{ID: "123", Name: "Test Task"}
+	story := &Story{ID: "456"}
+
+	conflicting := &StoryBase{Text: "hello", HTMLText: "<body>hello</body>"}
+
+	if _, err := task.CreateComment(client, conflicting); !errors.Is(err, ErrConflictingStoryText) {
+		t.Errorf("CreateComment() err = %v, want ErrConflictingStoryText", err)
+	}
+
+	if _, err := story.UpdateStory(client, conflicting); !errors.Is(err, ErrConflictingStoryText) {
+		t.Errorf("UpdateStory() err = %v, want ErrConflictingStoryText", err)
+	}
+}
+
+func TestStorySubtypeHelpers(t *testing.T) {
+	tests := []struct {
+		subtype      StorySubtype
+		wantComment  bool
+		wantSystem   bool
+		wantComplete bool
+		wantEvent    StoryEvent
+	}{
+		{StorySubtypeCommentAdded, true, false, false, CommentEvent{}},
+		{StorySubtypeCommentDeleted, true, false, false, CommentEvent{}},
+		{StorySubtypeCommentLiked, true, false, false, CommentEvent{}},
+		{StorySubtypeMarkedComplete, false, true, true, CompletedEvent{}},
+		{StorySubtypeMarkedIncomplete, false, true, false, CompletedEvent{}},
+		{StorySubtypeAssigned, false, true, false, AssignedEvent{}},
+		{StorySubtypeUnassigned, false, true, false, UnassignedEvent{}},
+		{StorySubtypeDueDateChanged, false, true, false, DueDateChangedEvent{}},
+		{StorySubtypeStartDateChanged, false, true, false, DueDateChangedEvent{}},
+		{StorySubtypeAddedToProject, false, true, false, MovedEvent{}},
+		{StorySubtypeRemovedFromProject, false, true, false, MovedEvent{}},
+		{StorySubtypeSectionChanged, false, true, false, MovedEvent{}},
+		{StorySubtypeNameChanged, false, true, false, GenericEvent{}},
+		{"", false, false, false, GenericEvent{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.subtype), func(t *testing.T) {
+			s := &Story{ResourceSubtype: tt.subtype}
+
+			if got := s.IsComment(); got != tt.wantComment {
+				t.Errorf("IsComment() = %v, want %v", got, tt.wantComment)
+			}
+			if got := s.IsSystem(); got != tt.wantSystem {
+				t.Errorf("IsSystem() = %v, want %v", got, tt.wantSystem)
+			}
+			if got := s.IsComplete(); got != tt.wantComplete {
+				t.Errorf("IsComplete() = %v, want %v", got, tt.wantComplete)
+			}
+
+			switch tt.wantEvent.(type) {
+			case CommentEvent:
+				if _, ok := s.Event().(CommentEvent); !ok {
+					t.Errorf("Event() = %T, want CommentEvent", s.Event())
+				}
+			case AssignedEvent:
+				if _, ok := s.Event().(AssignedEvent); !ok {
+					t.Errorf("Event() = %T, want AssignedEvent", s.Event())
+				}
+			case UnassignedEvent:
+				if _, ok := s.Event().(UnassignedEvent); !ok {
+					t.Errorf("Event() = %T, want UnassignedEvent", s.Event())
+				}
+			case DueDateChangedEvent:
+				if _, ok := s.Event().(DueDateChangedEvent); !ok {
+					t.Errorf("Event() = %T, want DueDateChangedEvent", s.Event())
+				}
+			case MovedEvent:
+				if _, ok := s.Event().(MovedEvent); !ok {
+					t.Errorf("Event() = %T, want MovedEvent", s.Event())
+				}
+			case CompletedEvent:
+				if _, ok := s.Event().(CompletedEvent); !ok {
+					t.Errorf("Event() = %T, want CompletedEvent", s.Event())
+				}
+			case GenericEvent:
+				if _, ok := s.Event().(GenericEvent); !ok {
+					t.Errorf("Event() = %T, want GenericEvent", s.Event())
+				}
+			}
+
+			if s.Event().Base() != s {
+				t.Errorf("Event().Base() = %v, want %v", s.Event().Base(), s)
+			}
+		})
+	}
+}